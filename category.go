@@ -0,0 +1,99 @@
+package main
+
+import "context"
+
+// Category is a first-class, nestable grouping products belong to via
+// product.CategoryID. ParentID of 0 marks a root category.
+type Category struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name" validate:"required,min=1"`
+	ParentID int    `json:"parent_id" validate:"gte=0"`
+	Sorter   int    `json:"sorter"`
+	Status   int    `json:"status"`
+}
+
+// CategoryNode is a Category with its children assembled into a tree.
+type CategoryNode struct {
+	Category
+	Children []*CategoryNode `json:"children,omitempty"`
+}
+
+// CategoryRepository is the storage contract the /categories endpoints are
+// built against.
+type CategoryRepository interface {
+	List(ctx context.Context) ([]Category, error)
+	Get(ctx context.Context, id int) (Category, error)
+	Create(ctx context.Context, c Category) (Category, error)
+	Update(ctx context.Context, id int, c Category) (Category, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// categoryChildren recursively assembles a flat list of rows into the
+// subtree rooted at parentID, so a single DB scan can build a hierarchy of
+// arbitrary depth without one query per level.
+func categoryChildren(rows []Category, parentID int) []*CategoryNode {
+	return categoryChildrenVisited(rows, parentID, map[int]bool{})
+}
+
+// categoryChildrenVisited is categoryChildren's worker, tracking the IDs
+// already descended into so a ParentID cycle (which should never exist,
+// but isn't rejected by every storage backend) stops the walk instead of
+// recursing forever.
+func categoryChildrenVisited(rows []Category, parentID int, visited map[int]bool) []*CategoryNode {
+	var children []*CategoryNode
+	for _, row := range rows {
+		if row.ParentID != parentID || visited[row.ID] {
+			continue
+		}
+		visited[row.ID] = true
+		children = append(children, &CategoryNode{
+			Category: row,
+			Children: categoryChildrenVisited(rows, row.ID, visited),
+		})
+	}
+	return children
+}
+
+// descendantCategoryIDs returns id plus the ID of every category nested
+// under it, by walking the tree built from rows.
+func descendantCategoryIDs(rows []Category, id int) []int {
+	ids := []int{id}
+	for _, child := range categoryChildren(rows, id) {
+		ids = append(ids, flattenCategoryIDs(child)...)
+	}
+	return ids
+}
+
+// flattenCategoryIDs returns the IDs of node and all of its descendants.
+func flattenCategoryIDs(node *CategoryNode) []int {
+	ids := []int{node.ID}
+	for _, child := range node.Children {
+		ids = append(ids, flattenCategoryIDs(child)...)
+	}
+	return ids
+}
+
+// wouldCreateCycle reports whether setting id's parent to newParentID would
+// make id its own ancestor, by walking newParentID's existing parent chain
+// in rows looking for id.
+func wouldCreateCycle(rows []Category, id, newParentID int) bool {
+	byID := make(map[int]Category, len(rows))
+	for _, row := range rows {
+		byID[row.ID] = row
+	}
+
+	visited := map[int]bool{}
+	for cur := newParentID; cur != 0; {
+		if cur == id || visited[cur] {
+			return true
+		}
+		visited[cur] = true
+
+		parent, ok := byID[cur]
+		if !ok {
+			return false
+		}
+		cur = parent.ParentID
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Dirkkoster444/GO-crud-api/validators"
+)
+
+func (s *Server) listCategories(w http.ResponseWriter, r *http.Request) {
+	categories, err := s.categories.List(r.Context())
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to list categories"))
+		return
+	}
+	respond(w, http.StatusOK, categories)
+}
+
+// categoryTree returns GET /categories/tree?root=<id> as categories nested
+// via children, assembled from a single flat scan of the table.
+func (s *Server) categoryTree(w http.ResponseWriter, r *http.Request) {
+	root := 0
+	if rootStr := r.FormValue("root"); rootStr != "" {
+		var err error
+		root, err = strconv.Atoi(rootStr)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, errors.New("root must be an integer"))
+			return
+		}
+	}
+
+	rows, err := s.categories.List(r.Context())
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to list categories"))
+		return
+	}
+
+	respond(w, http.StatusOK, categoryChildren(rows, root))
+}
+
+func (s *Server) getCategory(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, errors.New("category id must be an integer"))
+		return
+	}
+
+	c, err := s.categories.Get(r.Context(), id)
+	if errors.Is(err, ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, errors.New("category not found"))
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to get category"))
+		return
+	}
+	respond(w, http.StatusOK, c)
+}
+
+func (s *Server) createCategory(w http.ResponseWriter, r *http.Request) {
+	var newCategory Category
+	if err := json.NewDecoder(r.Body).Decode(&newCategory); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := validators.Struct(newCategory); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	created, err := s.categories.Create(r.Context(), newCategory)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to create category"))
+		return
+	}
+	respond(w, http.StatusCreated, created)
+}
+
+func (s *Server) updateCategory(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, errors.New("category id must be an integer"))
+		return
+	}
+
+	var newCategory Category
+	if err := json.NewDecoder(r.Body).Decode(&newCategory); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := validators.Struct(newCategory); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if newCategory.ParentID != 0 {
+		rows, err := s.categories.List(r.Context())
+		if err != nil {
+			respondWithError(w, http.StatusInternalServerError, errors.New("failed to list categories"))
+			return
+		}
+		if wouldCreateCycle(rows, id, newCategory.ParentID) {
+			respondWithError(w, http.StatusBadRequest, errors.New("parent_id cannot be the category itself or one of its descendants"))
+			return
+		}
+	}
+
+	updated, err := s.categories.Update(r.Context(), id, newCategory)
+	if errors.Is(err, ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, errors.New("category not found"))
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to update category"))
+		return
+	}
+	respond(w, http.StatusOK, updated)
+}
+
+func (s *Server) deleteCategory(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, errors.New("category id must be an integer"))
+		return
+	}
+
+	err = s.categories.Delete(r.Context(), id)
+	if errors.Is(err, ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, errors.New("category not found"))
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to delete category"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
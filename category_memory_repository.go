@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"sync"
+)
+
+// memoryCategoryRepository keeps categories in a mutex-guarded slice,
+// mirroring memoryProductRepository.
+type memoryCategoryRepository struct {
+	mu         sync.Mutex
+	categories []Category
+	nextID     int
+}
+
+func newMemoryCategoryRepository(seed []Category) *memoryCategoryRepository {
+	maxID := 0
+	for _, c := range seed {
+		if c.ID > maxID {
+			maxID = c.ID
+		}
+	}
+	return &memoryCategoryRepository{categories: seed, nextID: maxID + 1}
+}
+
+func (r *memoryCategoryRepository) List(ctx context.Context) ([]Category, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]Category, len(r.categories))
+	copy(out, r.categories)
+	return out, nil
+}
+
+func (r *memoryCategoryRepository) Get(ctx context.Context, id int) (Category, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range r.categories {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return Category{}, ErrNotFound
+}
+
+func (r *memoryCategoryRepository) Create(ctx context.Context, c Category) (Category, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c.ID = r.nextID
+	r.nextID++
+	r.categories = append(r.categories, c)
+	return c, nil
+}
+
+func (r *memoryCategoryRepository) Update(ctx context.Context, id int, c Category) (Category, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, item := range r.categories {
+		if item.ID == id {
+			c.ID = id
+			r.categories[i] = c
+			return c, nil
+		}
+	}
+	return Category{}, ErrNotFound
+}
+
+func (r *memoryCategoryRepository) Delete(ctx context.Context, id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, item := range r.categories {
+		if item.ID == id {
+			r.categories = append(r.categories[:i], r.categories[i+1:]...)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// sqlCategoryRepository is a database/sql backed CategoryRepository,
+// mirroring sqlProductRepository.
+type sqlCategoryRepository struct {
+	db     *sql.DB
+	driver string
+}
+
+func newSQLCategoryRepository(db *sql.DB, driver string) (*sqlCategoryRepository, error) {
+	repo := &sqlCategoryRepository{db: db, driver: driver}
+	if err := repo.migrate(); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+func (r *sqlCategoryRepository) migrate() error {
+	autoIncrement := "AUTO_INCREMENT"
+	if r.driver == "postgres" {
+		autoIncrement = "GENERATED ALWAYS AS IDENTITY"
+	}
+	_, err := r.db.Exec(`
+		CREATE TABLE IF NOT EXISTS categories (
+			id        INTEGER PRIMARY KEY ` + autoIncrement + `,
+			name      VARCHAR(255) NOT NULL,
+			parent_id INTEGER NOT NULL DEFAULT 0,
+			sorter    INTEGER NOT NULL DEFAULT 0,
+			status    INTEGER NOT NULL DEFAULT 1
+		)
+	`)
+	return err
+}
+
+func (r *sqlCategoryRepository) placeholder(n int) string {
+	if r.driver == "postgres" {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+func (r *sqlCategoryRepository) List(ctx context.Context) ([]Category, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT id, name, parent_id, sorter, status FROM categories ORDER BY sorter ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []Category
+	for rows.Next() {
+		var c Category
+		if err := rows.Scan(&c.ID, &c.Name, &c.ParentID, &c.Sorter, &c.Status); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+func (r *sqlCategoryRepository) Get(ctx context.Context, id int) (Category, error) {
+	var c Category
+	query := fmt.Sprintf("SELECT id, name, parent_id, sorter, status FROM categories WHERE id = %s", r.placeholder(1))
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&c.ID, &c.Name, &c.ParentID, &c.Sorter, &c.Status)
+	if err == sql.ErrNoRows {
+		return Category{}, ErrNotFound
+	}
+	return c, err
+}
+
+func (r *sqlCategoryRepository) Create(ctx context.Context, c Category) (Category, error) {
+	if r.driver == "postgres" {
+		query := fmt.Sprintf(
+			"INSERT INTO categories (name, parent_id, sorter, status) VALUES (%s, %s, %s, %s) RETURNING id",
+			r.placeholder(1), r.placeholder(2), r.placeholder(3), r.placeholder(4),
+		)
+		err := r.db.QueryRowContext(ctx, query, c.Name, c.ParentID, c.Sorter, c.Status).Scan(&c.ID)
+		return c, err
+	}
+
+	res, err := r.db.ExecContext(ctx,
+		"INSERT INTO categories (name, parent_id, sorter, status) VALUES (?, ?, ?, ?)",
+		c.Name, c.ParentID, c.Sorter, c.Status,
+	)
+	if err != nil {
+		return Category{}, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return Category{}, err
+	}
+	c.ID = int(id)
+	return c, nil
+}
+
+func (r *sqlCategoryRepository) Update(ctx context.Context, id int, c Category) (Category, error) {
+	query := fmt.Sprintf(
+		"UPDATE categories SET name = %s, parent_id = %s, sorter = %s, status = %s WHERE id = %s",
+		r.placeholder(1), r.placeholder(2), r.placeholder(3), r.placeholder(4), r.placeholder(5),
+	)
+	res, err := r.db.ExecContext(ctx, query, c.Name, c.ParentID, c.Sorter, c.Status, id)
+	if err != nil {
+		return Category{}, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return Category{}, ErrNotFound
+	}
+	c.ID = id
+	return c, nil
+}
+
+func (r *sqlCategoryRepository) Delete(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, fmt.Sprintf("DELETE FROM categories WHERE id = %s", r.placeholder(1)), id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCategoryChildren(t *testing.T) {
+	rows := []Category{
+		{ID: 1, Name: "root", ParentID: 0},
+		{ID: 2, Name: "child", ParentID: 1},
+		{ID: 3, Name: "grandchild", ParentID: 2},
+		{ID: 4, Name: "other root", ParentID: 0},
+	}
+
+	tree := categoryChildren(rows, 0)
+	if len(tree) != 2 {
+		t.Fatalf("got %d root nodes, want 2", len(tree))
+	}
+	if tree[0].ID != 1 || len(tree[0].Children) != 1 {
+		t.Fatalf("root node 1 should have exactly one child, got %+v", tree[0])
+	}
+	if tree[0].Children[0].ID != 2 || len(tree[0].Children[0].Children) != 1 {
+		t.Fatalf("child node 2 should have exactly one child, got %+v", tree[0].Children[0])
+	}
+}
+
+func TestCategoryChildrenIgnoresCycles(t *testing.T) {
+	// A points at B and B points at A; categoryChildren must not recurse
+	// forever when asked to build the subtree rooted at A.
+	rows := []Category{
+		{ID: 1, Name: "A", ParentID: 2},
+		{ID: 2, Name: "B", ParentID: 1},
+	}
+
+	done := make(chan []*CategoryNode, 1)
+	go func() { done <- categoryChildren(rows, 1) }()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("categoryChildren did not return, likely stuck recursing on a cycle")
+	}
+}
+
+func TestDescendantCategoryIDs(t *testing.T) {
+	rows := []Category{
+		{ID: 1, Name: "root", ParentID: 0},
+		{ID: 2, Name: "child", ParentID: 1},
+		{ID: 3, Name: "grandchild", ParentID: 2},
+		{ID: 4, Name: "unrelated", ParentID: 0},
+	}
+
+	got := descendantCategoryIDs(rows, 1)
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestWouldCreateCycle(t *testing.T) {
+	rows := []Category{
+		{ID: 1, Name: "A", ParentID: 0},
+		{ID: 2, Name: "B", ParentID: 1},
+		{ID: 3, Name: "C", ParentID: 2},
+		{ID: 4, Name: "D", ParentID: 0},
+	}
+
+	tests := []struct {
+		name        string
+		id          int
+		newParentID int
+		want        bool
+	}{
+		{name: "parent to its own descendant is a cycle", id: 1, newParentID: 3, want: true},
+		{name: "parent to itself is a cycle", id: 1, newParentID: 1, want: true},
+		{name: "parent to an unrelated root is fine", id: 3, newParentID: 4, want: false},
+		{name: "parent to root is fine", id: 2, newParentID: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wouldCreateCycle(rows, tt.id, tt.newParentID); got != tt.want {
+				t.Errorf("wouldCreateCycle(%d -> %d) = %v, want %v", tt.id, tt.newParentID, got, tt.want)
+			}
+		})
+	}
+}
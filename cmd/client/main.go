@@ -0,0 +1,43 @@
+// Command client is a small example that dials the gRPC server and calls
+// CalculatePrice, to validate the wiring end to end.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/Dirkkoster444/GO-crud-api/internal/pb"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:9091", "gRPC server address")
+	name := flag.String("name", "kaas", "product name to price")
+	quantity := flag.Int("quantity", 2, "quantity to price")
+	flag.Parse()
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("dial %s: %v", *addr, err)
+	}
+	defer conn.Close()
+
+	client := pb.NewProductServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.CalculatePrice(ctx, &pb.CalculatePriceRequest{
+		Name:     *name,
+		Quantity: int32(*quantity),
+	})
+	if err != nil {
+		log.Fatalf("CalculatePrice: %v", err)
+	}
+
+	log.Printf("%d x %s = %.2f (commission %.2f)", resp.GetQuantity(), resp.GetName(), resp.GetTotalPrice(), resp.GetCommission())
+}
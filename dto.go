@@ -0,0 +1,24 @@
+package main
+
+// listProductsRequest is the validated shape of the query params accepted
+// by GET /products.
+type listProductsRequest struct {
+	Limit    int     `validate:"required,min=1"`
+	Offset   int     `validate:"gte=0"`
+	MinPrice float64 `validate:"gte=0"`
+	MaxPrice float64 `validate:"gte=0"`
+	SortBy   string  `validate:"omitempty,oneof=LnH HnL"`
+}
+
+// calculatePriceRequest is the validated shape of the POST
+// /products/calculatePrice body.
+type calculatePriceRequest struct {
+	Name     string `json:"name" validate:"required"`
+	Quantity int    `json:"quantity" validate:"required,gte=1"`
+}
+
+// importProductRequest is the validated shape of the POST
+// /products/import body.
+type importProductRequest struct {
+	URL string `json:"url" validate:"required,url"`
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Dirkkoster444/GO-crud-api/internal/pb"
+	"github.com/Dirkkoster444/GO-crud-api/validators"
+)
+
+// grpcServer implements pb.ProductServiceServer against the same
+// ProductRepository the HTTP handlers use, so both transports share
+// business logic.
+type grpcServer struct {
+	pb.UnimplementedProductServiceServer
+	repo       ProductRepository
+	categories CategoryRepository
+	server     *Server
+}
+
+// newGRPCServer builds a pb.ProductServiceServer backed by repo and
+// categories, sharing server's active-promotion lookup so CalculatePrice
+// applies the same discount/commission logic as the HTTP handler.
+func newGRPCServer(repo ProductRepository, categories CategoryRepository, server *Server) *grpcServer {
+	return &grpcServer{repo: repo, categories: categories, server: server}
+}
+
+func toPBProduct(p product) *pb.Product {
+	return &pb.Product{
+		Id:          int32(p.ID),
+		Name:        p.Name,
+		Description: p.Description,
+		CategoryId:  int32(p.CategoryID),
+		Price:       p.Price,
+	}
+}
+
+func fromPBProduct(p *pb.Product) product {
+	return product{
+		ID:          int(p.GetId()),
+		Name:        p.GetName(),
+		Description: p.GetDescription(),
+		CategoryID:  int(p.GetCategoryId()),
+		Price:       p.GetPrice(),
+	}
+}
+
+func (s *grpcServer) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	listReq := listProductsRequest{
+		Limit:    int(req.GetLimit()),
+		Offset:   int(req.GetOffset()),
+		MinPrice: req.GetMinPrice(),
+		MaxPrice: req.GetMaxPrice(),
+		SortBy:   req.GetSortBy(),
+	}
+	if err := validators.Struct(listReq); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var categoryIDs []int
+	if req.GetCategoryId() != 0 {
+		if req.GetIncludeDescendants() {
+			rows, err := s.categories.List(ctx)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			categoryIDs = descendantCategoryIDs(rows, int(req.GetCategoryId()))
+		} else {
+			categoryIDs = []int{int(req.GetCategoryId())}
+		}
+	}
+
+	filter := ProductFilter{
+		MinPrice:    listReq.MinPrice,
+		MaxPrice:    listReq.MaxPrice,
+		Name:        req.GetName(),
+		SortBy:      listReq.SortBy,
+		CategoryIDs: categoryIDs,
+	}
+	page := Page{Limit: listReq.Limit, Offset: listReq.Offset}
+
+	products, total, err := s.repo.List(ctx, filter, page)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.ListProductsResponse{TotalItems: int32(total)}
+	for _, p := range products {
+		resp.Products = append(resp.Products, toPBProduct(p))
+	}
+	return resp, nil
+}
+
+func (s *grpcServer) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.Product, error) {
+	p, err := s.repo.Get(ctx, int(req.GetId()))
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return toPBProduct(p), nil
+}
+
+func (s *grpcServer) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.Product, error) {
+	newProduct := fromPBProduct(req.GetProduct())
+	if err := validators.Struct(newProduct); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	created, err := s.repo.Create(ctx, newProduct)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return toPBProduct(created), nil
+}
+
+func (s *grpcServer) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error) {
+	newProduct := fromPBProduct(req.GetProduct())
+	if err := validators.Struct(newProduct); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	updated, err := s.repo.Update(ctx, int(req.GetId()), newProduct)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return toPBProduct(updated), nil
+}
+
+func (s *grpcServer) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	err := s.repo.Delete(ctx, int(req.GetId()))
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.DeleteProductResponse{}, nil
+}
+
+func (s *grpcServer) CalculatePrice(ctx context.Context, req *pb.CalculatePriceRequest) (*pb.CalculatePriceResponse, error) {
+	found, err := s.repo.FindByName(ctx, req.GetName())
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	totalPrice := found.Price * float64(req.GetQuantity())
+
+	promo, err := s.server.activePromotionForProduct(ctx, found.ID, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	commissionPercent := 0.0
+	if promo != nil {
+		totalPrice -= totalPrice * promo.DiscountPercent / 100
+		commissionPercent = promo.CommissionPercent
+	}
+
+	return &pb.CalculatePriceResponse{
+		Name:       found.Name,
+		Quantity:   req.GetQuantity(),
+		TotalPrice: totalPrice,
+		Commission: totalPrice * commissionPercent / 100,
+	}, nil
+}
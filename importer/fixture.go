@@ -0,0 +1,28 @@
+package importer
+
+import "fmt"
+
+// FixtureShop serves Products from an in-memory map instead of a live
+// HTTP fetch, for sources that can't be scraped (or in local/dev setups
+// without network access).
+type FixtureShop struct {
+	domains  []string
+	fixtures map[string]Product
+}
+
+// NewFixtureShop builds a FixtureShop serving domains from fixtures,
+// keyed by the exact URL a Retrieve call would be given.
+func NewFixtureShop(domains []string, fixtures map[string]Product) *FixtureShop {
+	return &FixtureShop{domains: domains, fixtures: fixtures}
+}
+
+func (s *FixtureShop) Domains() []string { return s.domains }
+
+// Fetch looks url up in the fixture map.
+func (s *FixtureShop) Fetch(url string) (Product, error) {
+	p, ok := s.fixtures[url]
+	if !ok {
+		return Product{}, fmt.Errorf("no fixture registered for %s", url)
+	}
+	return p, nil
+}
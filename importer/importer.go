@@ -0,0 +1,60 @@
+// Package importer lets operators pull products in from external
+// storefronts. Inspired by the bazaar manager pattern, a Manager
+// dispatches an import URL to whichever Shop is registered for its
+// hostname, so new sources can be added by implementing Shop without
+// touching the HTTP layer.
+package importer
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Product is the normalized shape a Shop returns after fetching an
+// external listing.
+type Product struct {
+	Name        string
+	Description string
+	CategoryID  int
+	Price       float64
+}
+
+// Shop knows how to fetch a Product from the storefronts living at its
+// Domains.
+type Shop interface {
+	Domains() []string
+	Fetch(url string) (Product, error)
+}
+
+// Manager registers Shops by hostname and dispatches import requests to
+// the right one.
+type Manager struct {
+	shops map[string]Shop
+}
+
+// NewManager builds an empty Manager; call Register to add Shops.
+func NewManager() *Manager {
+	return &Manager{shops: make(map[string]Shop)}
+}
+
+// Register adds shop under every hostname it reports via Domains.
+func (m *Manager) Register(shop Shop) {
+	for _, domain := range shop.Domains() {
+		m.shops[domain] = shop
+	}
+}
+
+// Retrieve parses rawURL, dispatches to the Shop registered for its
+// hostname, and returns the normalized Product.
+func (m *Manager) Retrieve(rawURL string) (Product, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return Product{}, fmt.Errorf("parse url: %w", err)
+	}
+
+	shop, ok := m.shops[u.Hostname()]
+	if !ok {
+		return Product{}, fmt.Errorf("no shop registered for host %q", u.Hostname())
+	}
+	return shop.Fetch(rawURL)
+}
@@ -0,0 +1,62 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+)
+
+// OpenGraphShop is a generic scraper for storefronts that expose
+// OpenGraph product meta tags instead of a dedicated API. JSON-LD blocks
+// on the page are not parsed.
+type OpenGraphShop struct {
+	domains []string
+	client  *http.Client
+}
+
+// NewOpenGraphShop builds an OpenGraphShop serving pages from domains.
+func NewOpenGraphShop(domains []string) *OpenGraphShop {
+	return &OpenGraphShop{domains: domains, client: http.DefaultClient}
+}
+
+func (s *OpenGraphShop) Domains() []string { return s.domains }
+
+var (
+	ogTitleRE       = regexp.MustCompile(`<meta[^>]+property="og:title"[^>]+content="([^"]*)"`)
+	ogDescriptionRE = regexp.MustCompile(`<meta[^>]+property="og:description"[^>]+content="([^"]*)"`)
+	ogPriceRE       = regexp.MustCompile(`<meta[^>]+property="product:price:amount"[^>]+content="([^"]*)"`)
+)
+
+// Fetch downloads pageURL and reads its og:title, og:description and
+// product:price:amount meta tags into a Product.
+func (s *OpenGraphShop) Fetch(pageURL string) (Product, error) {
+	resp, err := s.client.Get(pageURL)
+	if err != nil {
+		return Product{}, fmt.Errorf("fetch %s: %w", pageURL, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Product{}, fmt.Errorf("read %s: %w", pageURL, err)
+	}
+
+	p := Product{
+		Name:        firstMatch(ogTitleRE, body),
+		Description: firstMatch(ogDescriptionRE, body),
+	}
+	if priceStr := firstMatch(ogPriceRE, body); priceStr != "" {
+		p.Price, _ = strconv.ParseFloat(priceStr, 64)
+	}
+	return p, nil
+}
+
+func firstMatch(re *regexp.Regexp, body []byte) string {
+	m := re.FindSubmatch(body)
+	if len(m) < 2 {
+		return ""
+	}
+	return string(m[1])
+}
@@ -0,0 +1,45 @@
+// Package libs holds small, dependency-light helpers shared across the
+// API that don't belong to any one domain package.
+package libs
+
+import "github.com/go-playground/validator/v10"
+
+// FieldError is the JSON-friendly shape of a single validation failure.
+type FieldError struct {
+	Field   string `json:"field"`
+	Tag     string `json:"tag"`
+	Message string `json:"message"`
+}
+
+// GetValidationErrors converts validator.ValidationErrors into FieldErrors
+// suitable for a JSON error response body.
+func GetValidationErrors(errs validator.ValidationErrors) []FieldError {
+	fieldErrors := make([]FieldError, 0, len(errs))
+	for _, fe := range errs {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   fe.Field(),
+			Tag:     fe.Tag(),
+			Message: fieldErrorMessage(fe),
+		})
+	}
+	return fieldErrors
+}
+
+func fieldErrorMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return fe.Field() + " is required"
+	case "min":
+		return fe.Field() + " must be at least " + fe.Param()
+	case "max":
+		return fe.Field() + " must be at most " + fe.Param()
+	case "gte":
+		return fe.Field() + " must be >= " + fe.Param()
+	case "lte":
+		return fe.Field() + " must be <= " + fe.Param()
+	case "oneof":
+		return fe.Field() + " must be one of: " + fe.Param()
+	default:
+		return fe.Field() + " is invalid"
+	}
+}
@@ -0,0 +1,36 @@
+package libs
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type testStruct struct {
+	Name string `validate:"required"`
+	Age  int    `validate:"gte=18"`
+}
+
+func TestGetValidationErrors(t *testing.T) {
+	err := validator.New().Struct(testStruct{Age: 10})
+	if err == nil {
+		t.Fatal("expected validation to fail")
+	}
+
+	fieldErrors := GetValidationErrors(err.(validator.ValidationErrors))
+	if len(fieldErrors) != 2 {
+		t.Fatalf("got %d field errors, want 2", len(fieldErrors))
+	}
+
+	byField := make(map[string]FieldError, len(fieldErrors))
+	for _, fe := range fieldErrors {
+		byField[fe.Field] = fe
+	}
+
+	if fe := byField["Name"]; fe.Tag != "required" || fe.Message != "Name is required" {
+		t.Errorf("got %+v, want required/Name is required", fe)
+	}
+	if fe := byField["Age"]; fe.Tag != "gte" || fe.Message != "Age must be >= 18" {
+		t.Errorf("got %+v, want gte/Age must be >= 18", fe)
+	}
+}
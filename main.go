@@ -0,0 +1,94 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+
+	"google.golang.org/grpc"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+
+	"github.com/Dirkkoster444/GO-crud-api/importer"
+	"github.com/Dirkkoster444/GO-crud-api/internal/pb"
+)
+
+func main() {
+	dbDriver := flag.String("db", "", "database driver to use: mysql, postgres, or empty for in-memory storage")
+	dsn := flag.String("dsn", os.Getenv("DATABASE_DSN"), "data source name for the selected -db driver")
+	grpcAddr := flag.String("grpc-addr", ":9091", "address the gRPC server listens on")
+	flag.Parse()
+
+	repo, categories, promotions, err := newRepositories(*dbDriver, *dsn)
+	if err != nil {
+		log.Fatalf("setting up storage: %v", err)
+	}
+
+	server := NewServer(repo, categories, promotions, newImportManager())
+
+	go serveGRPC(*grpcAddr, repo, categories, server)
+
+	// port config
+	log.Fatal(http.ListenAndServe(":9090", server.routes()))
+}
+
+// newImportManager builds the importer.Manager for POST /products/import,
+// registering every known Shop so operators can add new sources here
+// without touching the HTTP layer.
+func newImportManager() *importer.Manager {
+	mgr := importer.NewManager()
+	mgr.Register(importer.NewOpenGraphShop([]string{"example-shop.com", "www.example-shop.com"}))
+	mgr.Register(importer.NewFixtureShop([]string{"fixtures.local"}, map[string]importer.Product{
+		"https://fixtures.local/kaas": {Name: "kaas", Description: "een lekker stuk kaas", CategoryID: 1, Price: 50},
+	}))
+	return mgr
+}
+
+// serveGRPC starts the gRPC transport alongside the HTTP mux, sharing the
+// same repository and promotion lookup as server so both transports
+// expose the same business logic.
+func serveGRPC(addr string, repo ProductRepository, categories CategoryRepository, server *Server) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("listen on %s: %v", addr, err)
+	}
+
+	srv := grpc.NewServer()
+	pb.RegisterProductServiceServer(srv, newGRPCServer(repo, categories, server))
+
+	log.Fatal(srv.Serve(lis))
+}
+
+// newRepositories builds the ProductRepository, CategoryRepository and
+// PromotionRepository for the requested driver, falling back to in-memory
+// implementations sharing the demo seed data when none is given.
+func newRepositories(driver, dsn string) (ProductRepository, CategoryRepository, PromotionRepository, error) {
+	switch driver {
+	case "":
+		return newMemoryProductRepository(seedProducts), newMemoryCategoryRepository(seedCategories), newMemoryPromotionRepository(), nil
+	case "mysql", "postgres":
+		db, err := openDB(driver, dsn)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		products, err := newSQLProductRepository(db, driver)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		categories, err := newSQLCategoryRepository(db, driver)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		promotions, err := newSQLPromotionRepository(db, driver)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return products, categories, promotions, nil
+	default:
+		return nil, nil, nil, fmt.Errorf("unknown -db value %q: must be mysql, postgres, or empty", driver)
+	}
+}
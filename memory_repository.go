@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// memoryProductRepository keeps products in a mutex-guarded slice. It
+// preserves the original package-level-slice behavior behind the
+// ProductRepository interface and is the default when no -db flag is set.
+type memoryProductRepository struct {
+	mu       sync.Mutex
+	products []product
+	nextID   int
+}
+
+// newMemoryProductRepository seeds the repository and primes the ID
+// counter past the highest existing ID.
+func newMemoryProductRepository(seed []product) *memoryProductRepository {
+	maxID := 0
+	for _, p := range seed {
+		if p.ID > maxID {
+			maxID = p.ID
+		}
+	}
+	return &memoryProductRepository{products: seed, nextID: maxID + 1}
+}
+
+func (r *memoryProductRepository) List(ctx context.Context, filter ProductFilter, page Page) ([]product, int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	filtered := filterProducts(r.products, filter.MaxPrice, filter.MinPrice, filter.Name, filter.SortBy, filter.CategoryIDs)
+
+	total := len(filtered)
+	if page.Offset > total {
+		return []product{}, total, nil
+	}
+	end := page.Offset + page.Limit
+	if end > total {
+		end = total
+	}
+	return filtered[page.Offset:end], total, nil
+}
+
+func (r *memoryProductRepository) Get(ctx context.Context, id int) (product, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range r.products {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return product{}, ErrNotFound
+}
+
+func (r *memoryProductRepository) Create(ctx context.Context, p product) (product, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p.ID = r.nextID
+	r.nextID++
+	r.products = append(r.products, p)
+	return p, nil
+}
+
+func (r *memoryProductRepository) Update(ctx context.Context, id int, p product) (product, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, item := range r.products {
+		if item.ID == id {
+			p.ID = id
+			r.products[i] = p
+			return p, nil
+		}
+	}
+	return product{}, ErrNotFound
+}
+
+func (r *memoryProductRepository) Delete(ctx context.Context, id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, item := range r.products {
+		if item.ID == id {
+			r.products = append(r.products[:i], r.products[i+1:]...)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+func (r *memoryProductRepository) FindByName(ctx context.Context, name string) (product, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range r.products {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return product{}, ErrNotFound
+}
+
+// filterProducts narrows products down to the ones matching the given
+// criteria and applies the requested sort order. An empty categoryIDs
+// means "don't filter by category".
+func filterProducts(products []product, maxPrice float64, minPrice float64, name string, sortBy string, categoryIDs []int) []product {
+	var filtered []product
+	for _, p := range products {
+		if (minPrice <= 0 || p.Price >= minPrice) &&
+			(maxPrice <= 0 || p.Price <= maxPrice) &&
+			(name == "" || strings.Contains(strings.ToLower(p.Name), strings.ToLower(name))) &&
+			(len(categoryIDs) == 0 || containsInt(categoryIDs, p.CategoryID)) {
+			filtered = append(filtered, p)
+		}
+	}
+
+	if sortBy == "LnH" {
+		sort.Slice(filtered, func(i, j int) bool {
+			return filtered[i].Price < filtered[j].Price
+		})
+	} else if sortBy == "HnL" {
+		sort.Slice(filtered, func(i, j int) bool {
+			return filtered[i].Price > filtered[j].Price
+		})
+	}
+
+	return filtered
+}
+
+func containsInt(haystack []int, needle int) bool {
+	for _, v := range haystack {
+		if v == needle {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package main
+
+import "testing"
+
+func TestFilterProducts(t *testing.T) {
+	products := []product{
+		{ID: 1, Name: "kaas", Price: 50, CategoryID: 1},
+		{ID: 2, Name: "t-shirt", Price: 10, CategoryID: 2},
+		{ID: 3, Name: "nike air max", Price: 35, CategoryID: 3},
+	}
+
+	tests := []struct {
+		name        string
+		maxPrice    float64
+		minPrice    float64
+		productName string
+		sortBy      string
+		categoryIDs []int
+		wantIDs     []int
+	}{
+		{name: "no filter", wantIDs: []int{1, 2, 3}},
+		{name: "min price", minPrice: 20, wantIDs: []int{1, 3}},
+		{name: "max price", maxPrice: 20, wantIDs: []int{2}},
+		{name: "name substring is case-insensitive", productName: "NIKE", wantIDs: []int{3}},
+		{name: "category filter", categoryIDs: []int{2}, wantIDs: []int{2}},
+		{name: "sort low to high", sortBy: "LnH", wantIDs: []int{2, 3, 1}},
+		{name: "sort high to low", sortBy: "HnL", wantIDs: []int{1, 3, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterProducts(products, tt.maxPrice, tt.minPrice, tt.productName, tt.sortBy, tt.categoryIDs)
+			if len(got) != len(tt.wantIDs) {
+				t.Fatalf("got %d products, want %d", len(got), len(tt.wantIDs))
+			}
+			for i, p := range got {
+				if p.ID != tt.wantIDs[i] {
+					t.Errorf("product %d: got ID %d, want %d", i, p.ID, tt.wantIDs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestContainsInt(t *testing.T) {
+	if !containsInt([]int{1, 2, 3}, 2) {
+		t.Error("expected 2 to be found in [1 2 3]")
+	}
+	if containsInt([]int{1, 2, 3}, 4) {
+		t.Error("expected 4 not to be found in [1 2 3]")
+	}
+	if containsInt(nil, 1) {
+		t.Error("expected nothing to be found in a nil slice")
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+// Promotion is a time-windowed discount (for customers) and commission
+// (for the merchant) attached to a product.
+type Promotion struct {
+	ID                int       `json:"id"`
+	ProductID         int       `json:"product_id" validate:"required"`
+	StartTime         time.Time `json:"start_time" validate:"required"`
+	EndTime           time.Time `json:"end_time" validate:"required,gtfield=StartTime"`
+	DiscountPercent   float64   `json:"discount_percent" validate:"gte=0,lte=100"`
+	CommissionPercent float64   `json:"commission_percent" validate:"gte=0,lte=100"`
+}
+
+// Active reports whether the promotion's time window contains at.
+func (p Promotion) Active(at time.Time) bool {
+	return !at.Before(p.StartTime) && !at.After(p.EndTime)
+}
+
+// PromotionRepository is the storage contract the /promotions endpoints and
+// the calculatePrice discount lookup are built against.
+type PromotionRepository interface {
+	List(ctx context.Context) ([]Promotion, error)
+	ListByProduct(ctx context.Context, productID int) ([]Promotion, error)
+	Get(ctx context.Context, id int) (Promotion, error)
+	Create(ctx context.Context, p Promotion) (Promotion, error)
+	Update(ctx context.Context, id int, p Promotion) (Promotion, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// activePromotion picks the currently active promotion with the latest
+// start time out of promotions, or nil if none is active at `at`.
+func activePromotion(promotions []Promotion, at time.Time) *Promotion {
+	var active *Promotion
+	for i := range promotions {
+		p := promotions[i]
+		if p.Active(at) && (active == nil || p.StartTime.After(active.StartTime)) {
+			active = &p
+		}
+	}
+	return active
+}
+
+// warmingPromotions returns the promotions in promotions whose start time
+// is still in the future.
+func warmingPromotions(promotions []Promotion, at time.Time) []Promotion {
+	var warming []Promotion
+	for _, p := range promotions {
+		if p.StartTime.After(at) {
+			warming = append(warming, p)
+		}
+	}
+	return warming
+}
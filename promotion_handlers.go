@@ -0,0 +1,161 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Dirkkoster444/GO-crud-api/validators"
+)
+
+func (s *Server) listPromotions(w http.ResponseWriter, r *http.Request) {
+	promotions, err := s.promotions.List(r.Context())
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to list promotions"))
+		return
+	}
+	respond(w, http.StatusOK, promotions)
+}
+
+func (s *Server) getPromotion(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, errors.New("promotion id must be an integer"))
+		return
+	}
+
+	p, err := s.promotions.Get(r.Context(), id)
+	if errors.Is(err, ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, errors.New("promotion not found"))
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to get promotion"))
+		return
+	}
+	respond(w, http.StatusOK, p)
+}
+
+func (s *Server) createPromotion(w http.ResponseWriter, r *http.Request) {
+	var newPromotion Promotion
+	if err := json.NewDecoder(r.Body).Decode(&newPromotion); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := validators.Struct(newPromotion); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	created, err := s.promotions.Create(r.Context(), newPromotion)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to create promotion"))
+		return
+	}
+	s.promoCache.invalidate(created.ProductID)
+	respond(w, http.StatusCreated, created)
+}
+
+func (s *Server) updatePromotion(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, errors.New("promotion id must be an integer"))
+		return
+	}
+
+	var newPromotion Promotion
+	if err := json.NewDecoder(r.Body).Decode(&newPromotion); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := validators.Struct(newPromotion); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	existing, err := s.promotions.Get(r.Context(), id)
+	if errors.Is(err, ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, errors.New("promotion not found"))
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to get promotion"))
+		return
+	}
+
+	updated, err := s.promotions.Update(r.Context(), id, newPromotion)
+	if errors.Is(err, ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, errors.New("promotion not found"))
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to update promotion"))
+		return
+	}
+	s.promoCache.invalidate(existing.ProductID)
+	s.promoCache.invalidate(updated.ProductID)
+	respond(w, http.StatusOK, updated)
+}
+
+func (s *Server) deletePromotion(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, errors.New("promotion id must be an integer"))
+		return
+	}
+
+	promo, err := s.promotions.Get(r.Context(), id)
+	if errors.Is(err, ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, errors.New("promotion not found"))
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to get promotion"))
+		return
+	}
+
+	if err := s.promotions.Delete(r.Context(), id); err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to delete promotion"))
+		return
+	}
+	s.promoCache.invalidate(promo.ProductID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// productActivities handles GET /products/{id}/activities, returning all
+// promotions for the product sorted by start time.
+func (s *Server) productActivities(w http.ResponseWriter, r *http.Request) {
+	productID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, errors.New("product id must be an integer"))
+		return
+	}
+
+	promotions, err := s.promotions.ListByProduct(r.Context(), productID)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to list promotions"))
+		return
+	}
+	respond(w, http.StatusOK, promotions)
+}
+
+// productActivitiesWarming handles GET /products/{id}/activities/warming,
+// returning only the promotions whose start time is still in the future.
+func (s *Server) productActivitiesWarming(w http.ResponseWriter, r *http.Request) {
+	productID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, errors.New("product id must be an integer"))
+		return
+	}
+
+	promotions, err := s.promotions.ListByProduct(r.Context(), productID)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to list promotions"))
+		return
+	}
+	respond(w, http.StatusOK, warmingPromotions(promotions, time.Now()))
+}
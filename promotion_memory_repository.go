@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// memoryPromotionRepository keeps promotions in a mutex-guarded slice,
+// mirroring memoryProductRepository.
+type memoryPromotionRepository struct {
+	mu         sync.Mutex
+	promotions []Promotion
+	nextID     int
+}
+
+func newMemoryPromotionRepository() *memoryPromotionRepository {
+	return &memoryPromotionRepository{nextID: 1}
+}
+
+func (r *memoryPromotionRepository) List(ctx context.Context) ([]Promotion, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]Promotion, len(r.promotions))
+	copy(out, r.promotions)
+	return out, nil
+}
+
+func (r *memoryPromotionRepository) ListByProduct(ctx context.Context, productID int) ([]Promotion, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []Promotion
+	for _, p := range r.promotions {
+		if p.ProductID == productID {
+			out = append(out, p)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].StartTime.Before(out[j].StartTime)
+	})
+	return out, nil
+}
+
+func (r *memoryPromotionRepository) Get(ctx context.Context, id int) (Promotion, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range r.promotions {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return Promotion{}, ErrNotFound
+}
+
+func (r *memoryPromotionRepository) Create(ctx context.Context, p Promotion) (Promotion, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p.ID = r.nextID
+	r.nextID++
+	r.promotions = append(r.promotions, p)
+	return p, nil
+}
+
+func (r *memoryPromotionRepository) Update(ctx context.Context, id int, p Promotion) (Promotion, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, item := range r.promotions {
+		if item.ID == id {
+			p.ID = id
+			r.promotions[i] = p
+			return p, nil
+		}
+	}
+	return Promotion{}, ErrNotFound
+}
+
+func (r *memoryPromotionRepository) Delete(ctx context.Context, id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, item := range r.promotions {
+		if item.ID == id {
+			r.promotions = append(r.promotions[:i], r.promotions[i+1:]...)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// sqlPromotionRepository is a database/sql backed PromotionRepository,
+// mirroring sqlProductRepository.
+type sqlPromotionRepository struct {
+	db     *sql.DB
+	driver string
+}
+
+func newSQLPromotionRepository(db *sql.DB, driver string) (*sqlPromotionRepository, error) {
+	repo := &sqlPromotionRepository{db: db, driver: driver}
+	if err := repo.migrate(); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+func (r *sqlPromotionRepository) migrate() error {
+	autoIncrement := "AUTO_INCREMENT"
+	if r.driver == "postgres" {
+		autoIncrement = "GENERATED ALWAYS AS IDENTITY"
+	}
+	_, err := r.db.Exec(`
+		CREATE TABLE IF NOT EXISTS promotions (
+			id                 INTEGER PRIMARY KEY ` + autoIncrement + `,
+			product_id         INTEGER NOT NULL,
+			start_time         TIMESTAMP NOT NULL,
+			end_time           TIMESTAMP NOT NULL,
+			discount_percent   DECIMAL(5,2) NOT NULL DEFAULT 0,
+			commission_percent DECIMAL(5,2) NOT NULL DEFAULT 0
+		)
+	`)
+	return err
+}
+
+func (r *sqlPromotionRepository) placeholder(n int) string {
+	if r.driver == "postgres" {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+func (r *sqlPromotionRepository) scanAll(rows *sql.Rows) ([]Promotion, error) {
+	defer rows.Close()
+	var out []Promotion
+	for rows.Next() {
+		var p Promotion
+		if err := rows.Scan(&p.ID, &p.ProductID, &p.StartTime, &p.EndTime, &p.DiscountPercent, &p.CommissionPercent); err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, rows.Err()
+}
+
+func (r *sqlPromotionRepository) List(ctx context.Context) ([]Promotion, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT id, product_id, start_time, end_time, discount_percent, commission_percent FROM promotions ORDER BY start_time ASC")
+	if err != nil {
+		return nil, err
+	}
+	return r.scanAll(rows)
+}
+
+func (r *sqlPromotionRepository) ListByProduct(ctx context.Context, productID int) ([]Promotion, error) {
+	query := fmt.Sprintf(
+		"SELECT id, product_id, start_time, end_time, discount_percent, commission_percent FROM promotions WHERE product_id = %s ORDER BY start_time ASC",
+		r.placeholder(1),
+	)
+	rows, err := r.db.QueryContext(ctx, query, productID)
+	if err != nil {
+		return nil, err
+	}
+	return r.scanAll(rows)
+}
+
+func (r *sqlPromotionRepository) Get(ctx context.Context, id int) (Promotion, error) {
+	var p Promotion
+	query := fmt.Sprintf(
+		"SELECT id, product_id, start_time, end_time, discount_percent, commission_percent FROM promotions WHERE id = %s",
+		r.placeholder(1),
+	)
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&p.ID, &p.ProductID, &p.StartTime, &p.EndTime, &p.DiscountPercent, &p.CommissionPercent)
+	if err == sql.ErrNoRows {
+		return Promotion{}, ErrNotFound
+	}
+	return p, err
+}
+
+func (r *sqlPromotionRepository) Create(ctx context.Context, p Promotion) (Promotion, error) {
+	if r.driver == "postgres" {
+		query := fmt.Sprintf(
+			"INSERT INTO promotions (product_id, start_time, end_time, discount_percent, commission_percent) VALUES (%s, %s, %s, %s, %s) RETURNING id",
+			r.placeholder(1), r.placeholder(2), r.placeholder(3), r.placeholder(4), r.placeholder(5),
+		)
+		err := r.db.QueryRowContext(ctx, query, p.ProductID, p.StartTime, p.EndTime, p.DiscountPercent, p.CommissionPercent).Scan(&p.ID)
+		return p, err
+	}
+
+	res, err := r.db.ExecContext(ctx,
+		"INSERT INTO promotions (product_id, start_time, end_time, discount_percent, commission_percent) VALUES (?, ?, ?, ?, ?)",
+		p.ProductID, p.StartTime, p.EndTime, p.DiscountPercent, p.CommissionPercent,
+	)
+	if err != nil {
+		return Promotion{}, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return Promotion{}, err
+	}
+	p.ID = int(id)
+	return p, nil
+}
+
+func (r *sqlPromotionRepository) Update(ctx context.Context, id int, p Promotion) (Promotion, error) {
+	query := fmt.Sprintf(
+		"UPDATE promotions SET product_id = %s, start_time = %s, end_time = %s, discount_percent = %s, commission_percent = %s WHERE id = %s",
+		r.placeholder(1), r.placeholder(2), r.placeholder(3), r.placeholder(4), r.placeholder(5), r.placeholder(6),
+	)
+	res, err := r.db.ExecContext(ctx, query, p.ProductID, p.StartTime, p.EndTime, p.DiscountPercent, p.CommissionPercent, id)
+	if err != nil {
+		return Promotion{}, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return Promotion{}, ErrNotFound
+	}
+	p.ID = id
+	return p, nil
+}
+
+func (r *sqlPromotionRepository) Delete(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, fmt.Sprintf("DELETE FROM promotions WHERE id = %s", r.placeholder(1)), id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
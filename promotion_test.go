@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestActivePromotion(t *testing.T) {
+	now := time.Now()
+	promotions := []Promotion{
+		{ID: 1, StartTime: now.Add(-2 * time.Hour), EndTime: now.Add(-1 * time.Hour)},   // expired
+		{ID: 2, StartTime: now.Add(-1 * time.Hour), EndTime: now.Add(1 * time.Hour)},    // active, earlier start
+		{ID: 3, StartTime: now.Add(-30 * time.Minute), EndTime: now.Add(1 * time.Hour)}, // active, later start
+		{ID: 4, StartTime: now.Add(1 * time.Hour), EndTime: now.Add(2 * time.Hour)},     // not started yet
+	}
+
+	got := activePromotion(promotions, now)
+	if got == nil || got.ID != 3 {
+		t.Fatalf("got %+v, want promotion 3 (active with the latest start time)", got)
+	}
+
+	if got := activePromotion(nil, now); got != nil {
+		t.Errorf("expected no active promotion, got %+v", got)
+	}
+}
+
+func TestWarmingPromotions(t *testing.T) {
+	now := time.Now()
+	promotions := []Promotion{
+		{ID: 1, StartTime: now.Add(-1 * time.Hour)},
+		{ID: 2, StartTime: now.Add(1 * time.Hour)},
+		{ID: 3, StartTime: now.Add(2 * time.Hour)},
+	}
+
+	got := warmingPromotions(promotions, now)
+	if len(got) != 2 || got[0].ID != 2 || got[1].ID != 3 {
+		t.Fatalf("got %+v, want promotions 2 and 3", got)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned by ProductRepository implementations when a
+// product does not exist.
+var ErrNotFound = errors.New("product not found")
+
+// ProductFilter describes the criteria products are narrowed down by.
+// An empty value for a field means "don't filter on it".
+type ProductFilter struct {
+	MinPrice    float64
+	MaxPrice    float64
+	Name        string
+	CategoryIDs []int // when non-empty, restrict to these category IDs
+	SortBy      string // "LnH", "HnL" or "" for unsorted
+}
+
+// Page describes the slice of the filtered results to return.
+type Page struct {
+	Limit  int
+	Offset int
+}
+
+// ProductRepository is the storage contract the HTTP (and later gRPC)
+// transport is built against, so handlers never depend on how products are
+// actually persisted. Filtering, sorting and pagination are the
+// responsibility of the implementation, not the caller.
+type ProductRepository interface {
+	List(ctx context.Context, filter ProductFilter, page Page) ([]product, int, error)
+	Get(ctx context.Context, id int) (product, error)
+	Create(ctx context.Context, p product) (product, error)
+	Update(ctx context.Context, id int, p product) (product, error)
+	Delete(ctx context.Context, id int) error
+	FindByName(ctx context.Context, name string) (product, error)
+}
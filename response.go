@@ -0,0 +1,38 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/Dirkkoster444/GO-crud-api/libs"
+)
+
+// errorResponse is the JSON shape every error response from this API
+// takes: {"errors":[{"field":"...","tag":"...","message":"..."}]}.
+type errorResponse struct {
+	Errors []libs.FieldError `json:"errors"`
+}
+
+// respond writes body as a JSON response with the given status code. Every
+// handler routes its responses through this (or respondWithError), except
+// the DELETE handlers, which write a bare 204 No Content with no body.
+func respond(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// respondWithError writes err as a JSON error response. validator.ValidationErrors
+// is expanded into field-level detail; anything else becomes a single
+// generic entry carrying err's message.
+func respondWithError(w http.ResponseWriter, status int, err error) {
+	var valErrs validator.ValidationErrors
+	if errors.As(err, &valErrs) {
+		respond(w, status, errorResponse{Errors: libs.GetValidationErrors(valErrs)})
+		return
+	}
+	respond(w, status, errorResponse{Errors: []libs.FieldError{{Message: err.Error()}}})
+}
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Dirkkoster444/GO-crud-api/importer"
+	"github.com/Dirkkoster444/GO-crud-api/validators"
+)
+
+// shopManager is the subset of *importer.Manager the Server depends on,
+// so handlers can be exercised against a fake without a real Manager.
+type shopManager interface {
+	Retrieve(rawURL string) (importer.Product, error)
+}
+
+// Server holds the dependencies the HTTP handlers need, so the handlers
+// stay thin and can run against any ProductRepository/CategoryRepository/
+// PromotionRepository implementation.
+type Server struct {
+	repo       ProductRepository
+	categories CategoryRepository
+	promotions PromotionRepository
+	promoCache *skuCache
+	importer   shopManager
+}
+
+// NewServer builds a Server backed by repo, categories, promotions and
+// the shops registered with importMgr.
+func NewServer(repo ProductRepository, categories CategoryRepository, promotions PromotionRepository, importMgr shopManager) *Server {
+	return &Server{repo: repo, categories: categories, promotions: promotions, promoCache: newSKUCache(), importer: importMgr}
+}
+
+// activePromotionForProduct returns the currently active promotion for
+// productID, consulting the sku cache before falling back to the
+// PromotionRepository.
+func (s *Server) activePromotionForProduct(ctx context.Context, productID int, now time.Time) (*Promotion, error) {
+	if cached, ok := s.promoCache.get(productID, now); ok {
+		return cached, nil
+	}
+
+	promotions, err := s.promotions.ListByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	active := activePromotion(promotions, now)
+	s.promoCache.set(productID, active, now)
+	return active, nil
+}
+
+// routes wires up the API endpoints on a fresh router.
+func (s *Server) routes() *mux.Router {
+	router := mux.NewRouter()
+
+	router.HandleFunc("/products", s.getProducts).Methods("GET")
+	router.HandleFunc("/products/{id}", s.getProduct).Methods("GET")
+	router.HandleFunc("/products/{id}", s.updateProduct).Methods("PUT")
+	router.HandleFunc("/products", s.addProduct).Methods("POST")
+	router.HandleFunc("/products/{id}", s.deleteProduct).Methods("DELETE")
+	router.HandleFunc("/products/calculatePrice", s.calculatePrice).Methods("POST")
+	router.HandleFunc("/products/import", s.importProduct).Methods("POST")
+
+	router.HandleFunc("/categories", s.listCategories).Methods("GET")
+	router.HandleFunc("/categories/tree", s.categoryTree).Methods("GET")
+	router.HandleFunc("/categories/{id}", s.getCategory).Methods("GET")
+	router.HandleFunc("/categories", s.createCategory).Methods("POST")
+	router.HandleFunc("/categories/{id}", s.updateCategory).Methods("PUT")
+	router.HandleFunc("/categories/{id}", s.deleteCategory).Methods("DELETE")
+
+	router.HandleFunc("/promotions", s.listPromotions).Methods("GET")
+	router.HandleFunc("/promotions/{id}", s.getPromotion).Methods("GET")
+	router.HandleFunc("/promotions", s.createPromotion).Methods("POST")
+	router.HandleFunc("/promotions/{id}", s.updatePromotion).Methods("PUT")
+	router.HandleFunc("/promotions/{id}", s.deletePromotion).Methods("DELETE")
+	router.HandleFunc("/products/{id}/activities", s.productActivities).Methods("GET")
+	router.HandleFunc("/products/{id}/activities/warming", s.productActivitiesWarming).Methods("GET")
+
+	return router
+}
+
+// parseIntParam parses the query param name as an int, returning 0 for a
+// param that wasn't given at all rather than silently treating a
+// malformed value the same way.
+func parseIntParam(r *http.Request, name string) (int, error) {
+	raw := r.FormValue(name)
+	if raw == "" {
+		return 0, nil
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer", name)
+	}
+	return v, nil
+}
+
+// parseFloatParam parses the query param name as a float64, returning 0
+// for a param that wasn't given at all rather than silently treating a
+// malformed value the same way.
+func parseFloatParam(r *http.Request, name string) (float64, error) {
+	raw := r.FormValue(name)
+	if raw == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a number", name)
+	}
+	return v, nil
+}
+
+// function to get all products
+func (s *Server) getProducts(w http.ResponseWriter, r *http.Request) {
+	limit, err := parseIntParam(r, "limit")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+	offset, err := parseIntParam(r, "offset")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+	minPrice, err := parseFloatParam(r, "min_price")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+	maxPrice, err := parseFloatParam(r, "max_price")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+	name := r.FormValue("name")
+	sortBy := r.FormValue("sort_by")
+	categoryIDStr := r.FormValue("category_id")
+	includeDescendants := r.FormValue("include_descendants") == "true"
+
+	req := listProductsRequest{Limit: limit, Offset: offset, MinPrice: minPrice, MaxPrice: maxPrice, SortBy: sortBy}
+	if err := validators.Struct(req); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+	if maxPrice > 0 && maxPrice < minPrice {
+		respondWithError(w, http.StatusBadRequest, errors.New("max_price cannot be less than min_price"))
+		return
+	}
+
+	var categoryIDs []int
+	if categoryIDStr != "" {
+		categoryID, err := strconv.Atoi(categoryIDStr)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, errors.New("category_id must be an integer"))
+			return
+		}
+		if includeDescendants {
+			rows, err := s.categories.List(r.Context())
+			if err != nil {
+				respondWithError(w, http.StatusInternalServerError, errors.New("failed to list categories"))
+				return
+			}
+			categoryIDs = descendantCategoryIDs(rows, categoryID)
+		} else {
+			categoryIDs = []int{categoryID}
+		}
+	}
+
+	filter := ProductFilter{
+		MinPrice:    minPrice,
+		MaxPrice:    maxPrice,
+		Name:        name,
+		SortBy:      sortBy,
+		CategoryIDs: categoryIDs,
+	}
+	page := Page{Limit: limit, Offset: offset}
+
+	filteredProducts, total, err := s.repo.List(r.Context(), filter, page)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to list products"))
+		return
+	}
+
+	respond(w, http.StatusOK, PaginatedResponse{
+		Data: filteredProducts,
+		Pagination: Pagination{
+			CurrentPage: (offset / limit) + 1,
+			TotalPages:  (total + limit - 1) / limit,
+			TotalItems:  total,
+			Limit:       limit,
+			Offset:      offset,
+		},
+	})
+}
+
+// function to get a single product
+func (s *Server) getProduct(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, errors.New("product id must be an integer"))
+		return
+	}
+
+	p, err := s.repo.Get(r.Context(), id)
+	if errors.Is(err, ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, errors.New("product not found"))
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to get product"))
+		return
+	}
+	respond(w, http.StatusOK, p)
+}
+
+func (s *Server) calculatePrice(w http.ResponseWriter, r *http.Request) {
+	var req calculatePriceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := validators.Struct(req); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	foundProduct, err := s.repo.FindByName(r.Context(), req.Name)
+	if errors.Is(err, ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, errors.New("product not found"))
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to look up product"))
+		return
+	}
+	totalPrice := foundProduct.Price * float64(req.Quantity)
+
+	promo, err := s.activePromotionForProduct(r.Context(), foundProduct.ID, time.Now())
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to look up promotions"))
+		return
+	}
+
+	commissionPercent := 0.0
+	if promo != nil {
+		totalPrice -= totalPrice * promo.DiscountPercent / 100
+		commissionPercent = promo.CommissionPercent
+	}
+
+	respond(w, http.StatusOK, PriceResponse{
+		Name:       foundProduct.Name,
+		Quantity:   req.Quantity,
+		TotalPrice: totalPrice,
+		Commission: totalPrice * commissionPercent / 100,
+	})
+}
+
+// function to add product
+func (s *Server) addProduct(w http.ResponseWriter, r *http.Request) {
+	var newProduct product
+	if err := json.NewDecoder(r.Body).Decode(&newProduct); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := validators.Struct(newProduct); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	created, err := s.repo.Create(r.Context(), newProduct)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to create product"))
+		return
+	}
+	respond(w, http.StatusCreated, created)
+}
+
+// importProduct handles POST /products/import, fetching the product
+// listed at the given URL from its registered Shop and storing the
+// result via the repository.
+func (s *Server) importProduct(w http.ResponseWriter, r *http.Request) {
+	var req importProductRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := validators.Struct(req); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	imported, err := s.importer.Retrieve(req.URL)
+	if err != nil {
+		respondWithError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	newProduct := product{
+		Name:        imported.Name,
+		Description: imported.Description,
+		CategoryID:  imported.CategoryID,
+		Price:       imported.Price,
+	}
+	if err := validators.Struct(newProduct); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	created, err := s.repo.Create(r.Context(), newProduct)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to create product"))
+		return
+	}
+	respond(w, http.StatusCreated, created)
+}
+
+// function to delete product
+func (s *Server) deleteProduct(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, errors.New("product id must be an integer"))
+		return
+	}
+
+	err = s.repo.Delete(r.Context(), id)
+	if errors.Is(err, ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, errors.New("product not found"))
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to delete product"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Function to update product
+func (s *Server) updateProduct(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, errors.New("product id must be an integer"))
+		return
+	}
+
+	var newProduct product
+	if err := json.NewDecoder(r.Body).Decode(&newProduct); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := validators.Struct(newProduct); err != nil {
+		respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	updated, err := s.repo.Update(r.Context(), id, newProduct)
+	if errors.Is(err, ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, errors.New("product not found"))
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, errors.New("failed to update product"))
+		return
+	}
+	respond(w, http.StatusOK, updated)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// skuCacheTTL is how long an active-promotion lookup is cached before it is
+// recomputed from the repository.
+const skuCacheTTL = 30 * time.Second
+
+// skuCacheEntry holds a cached active-promotion lookup for one product. A
+// nil promotion is a valid, cacheable result meaning "no active promotion".
+type skuCacheEntry struct {
+	promotion *Promotion
+	expiresAt time.Time
+}
+
+// skuCache caches the active promotion per product ID, keyed by productID,
+// so calculatePrice doesn't rescan a product's promotions on every request.
+// Entries expire after skuCacheTTL and are invalidated immediately on
+// promotion writes.
+type skuCache struct {
+	mu      sync.Mutex
+	entries map[int]skuCacheEntry
+}
+
+func newSKUCache() *skuCache {
+	return &skuCache{entries: make(map[int]skuCacheEntry)}
+}
+
+func (c *skuCache) get(productID int, now time.Time) (*Promotion, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[productID]
+	if !ok || now.After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.promotion, true
+}
+
+func (c *skuCache) set(productID int, promotion *Promotion, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[productID] = skuCacheEntry{promotion: promotion, expiresAt: now.Add(skuCacheTTL)}
+}
+
+func (c *skuCache) invalidate(productID int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, productID)
+}
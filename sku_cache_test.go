@@ -0,0 +1,46 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSKUCacheGetSet(t *testing.T) {
+	c := newSKUCache()
+	now := time.Now()
+
+	if _, ok := c.get(1, now); ok {
+		t.Fatal("expected a miss on an empty cache")
+	}
+
+	promo := &Promotion{ID: 1, ProductID: 1}
+	c.set(1, promo, now)
+
+	got, ok := c.get(1, now)
+	if !ok || got != promo {
+		t.Fatalf("got (%+v, %v), want the cached promotion", got, ok)
+	}
+}
+
+func TestSKUCacheExpires(t *testing.T) {
+	c := newSKUCache()
+	now := time.Now()
+
+	c.set(1, &Promotion{ID: 1}, now)
+
+	if _, ok := c.get(1, now.Add(skuCacheTTL+time.Second)); ok {
+		t.Fatal("expected the entry to have expired")
+	}
+}
+
+func TestSKUCacheInvalidate(t *testing.T) {
+	c := newSKUCache()
+	now := time.Now()
+
+	c.set(1, &Promotion{ID: 1}, now)
+	c.invalidate(1)
+
+	if _, ok := c.get(1, now); ok {
+		t.Fatal("expected the entry to be gone after invalidate")
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// sqlProductRepository is a database/sql backed ProductRepository. It pushes
+// filtering, sorting and pagination down into the query instead of loading
+// every row into memory, and supports either MySQL or Postgres depending on
+// the driver it was opened with.
+type sqlProductRepository struct {
+	db     *sql.DB
+	driver string // "mysql" or "postgres"
+}
+
+// openDB opens dsn with driver and verifies the connection, similar to the
+// connection setup used in the visionet.co.id example this was modeled on.
+func openDB(driver, dsn string) (*sql.DB, error) {
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", driver, err)
+	}
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("ping %s: %w", driver, err)
+	}
+	return db, nil
+}
+
+// newSQLProductRepository runs the products table migration against db.
+func newSQLProductRepository(db *sql.DB, driver string) (*sqlProductRepository, error) {
+	repo := &sqlProductRepository{db: db, driver: driver}
+	if err := repo.migrate(); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+func (r *sqlProductRepository) migrate() error {
+	_, err := r.db.Exec(`
+		CREATE TABLE IF NOT EXISTS products (
+			id          INTEGER PRIMARY KEY ` + r.autoIncrement() + `,
+			name        VARCHAR(255) NOT NULL,
+			description TEXT,
+			category_id INTEGER NOT NULL DEFAULT 0,
+			price       DECIMAL(10,2) NOT NULL
+		)
+	`)
+	return err
+}
+
+func (r *sqlProductRepository) autoIncrement() string {
+	if r.driver == "postgres" {
+		return "GENERATED ALWAYS AS IDENTITY"
+	}
+	return "AUTO_INCREMENT"
+}
+
+// placeholder returns the positional placeholder for the n-th argument,
+// since postgres uses $1, $2... while mysql uses plain ?.
+func (r *sqlProductRepository) placeholder(n int) string {
+	if r.driver == "postgres" {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+func (r *sqlProductRepository) List(ctx context.Context, filter ProductFilter, page Page) ([]product, int, error) {
+	where := "WHERE 1=1"
+	var args []interface{}
+
+	if filter.MinPrice > 0 {
+		args = append(args, filter.MinPrice)
+		where += fmt.Sprintf(" AND price >= %s", r.placeholder(len(args)))
+	}
+	if filter.MaxPrice > 0 {
+		args = append(args, filter.MaxPrice)
+		where += fmt.Sprintf(" AND price <= %s", r.placeholder(len(args)))
+	}
+	if filter.Name != "" {
+		args = append(args, "%"+filter.Name+"%")
+		where += fmt.Sprintf(" AND LOWER(name) LIKE LOWER(%s)", r.placeholder(len(args)))
+	}
+	if len(filter.CategoryIDs) > 0 {
+		placeholders := make([]string, len(filter.CategoryIDs))
+		for i, id := range filter.CategoryIDs {
+			args = append(args, id)
+			placeholders[i] = r.placeholder(len(args))
+		}
+		where += fmt.Sprintf(" AND category_id IN (%s)", strings.Join(placeholders, ", "))
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM products "+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	order := ""
+	switch filter.SortBy {
+	case "LnH":
+		order = " ORDER BY price ASC"
+	case "HnL":
+		order = " ORDER BY price DESC"
+	}
+
+	args = append(args, page.Limit, page.Offset)
+	query := fmt.Sprintf(
+		"SELECT id, name, description, category_id, price FROM products %s%s LIMIT %s OFFSET %s",
+		where, order, r.placeholder(len(args)-1), r.placeholder(len(args)),
+	)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var results []product
+	for rows.Next() {
+		var p product
+		if err := rows.Scan(&p.ID, &p.Name, &p.Description, &p.CategoryID, &p.Price); err != nil {
+			return nil, 0, err
+		}
+		results = append(results, p)
+	}
+	return results, total, rows.Err()
+}
+
+func (r *sqlProductRepository) Get(ctx context.Context, id int) (product, error) {
+	var p product
+	query := fmt.Sprintf("SELECT id, name, description, category_id, price FROM products WHERE id = %s", r.placeholder(1))
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&p.ID, &p.Name, &p.Description, &p.CategoryID, &p.Price)
+	if err == sql.ErrNoRows {
+		return product{}, ErrNotFound
+	}
+	return p, err
+}
+
+func (r *sqlProductRepository) Create(ctx context.Context, p product) (product, error) {
+	if r.driver == "postgres" {
+		query := fmt.Sprintf(
+			"INSERT INTO products (name, description, category_id, price) VALUES (%s, %s, %s, %s) RETURNING id",
+			r.placeholder(1), r.placeholder(2), r.placeholder(3), r.placeholder(4),
+		)
+		err := r.db.QueryRowContext(ctx, query, p.Name, p.Description, p.CategoryID, p.Price).Scan(&p.ID)
+		return p, err
+	}
+
+	res, err := r.db.ExecContext(ctx,
+		"INSERT INTO products (name, description, category_id, price) VALUES (?, ?, ?, ?)",
+		p.Name, p.Description, p.CategoryID, p.Price,
+	)
+	if err != nil {
+		return product{}, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return product{}, err
+	}
+	p.ID = int(id)
+	return p, nil
+}
+
+func (r *sqlProductRepository) Update(ctx context.Context, id int, p product) (product, error) {
+	query := fmt.Sprintf(
+		"UPDATE products SET name = %s, description = %s, category_id = %s, price = %s WHERE id = %s",
+		r.placeholder(1), r.placeholder(2), r.placeholder(3), r.placeholder(4), r.placeholder(5),
+	)
+	res, err := r.db.ExecContext(ctx, query, p.Name, p.Description, p.CategoryID, p.Price, id)
+	if err != nil {
+		return product{}, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return product{}, ErrNotFound
+	}
+	p.ID = id
+	return p, nil
+}
+
+func (r *sqlProductRepository) Delete(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, fmt.Sprintf("DELETE FROM products WHERE id = %s", r.placeholder(1)), id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *sqlProductRepository) FindByName(ctx context.Context, name string) (product, error) {
+	var p product
+	query := fmt.Sprintf("SELECT id, name, description, category_id, price FROM products WHERE name = %s", r.placeholder(1))
+	err := r.db.QueryRowContext(ctx, query, name).Scan(&p.ID, &p.Name, &p.Description, &p.CategoryID, &p.Price)
+	if err == sql.ErrNoRows {
+		return product{}, ErrNotFound
+	}
+	return p, err
+}
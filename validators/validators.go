@@ -0,0 +1,16 @@
+// Package validators provides the struct-tag validation used across the
+// HTTP handlers, built on github.com/go-playground/validator/v10.
+package validators
+
+import "github.com/go-playground/validator/v10"
+
+// validate is shared across calls since it caches struct tag parsing
+// internally and is safe for concurrent use.
+var validate = validator.New()
+
+// Struct validates s against its `validate` tags. The returned error is a
+// validator.ValidationErrors when validation fails, which callers can pass
+// straight to libs.GetValidationErrors.
+func Struct(s interface{}) error {
+	return validate.Struct(s)
+}